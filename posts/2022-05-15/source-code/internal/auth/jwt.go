@@ -0,0 +1,74 @@
+package auth
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// Claims is the JWT payload issued on login. Username and Role are trusted
+// by middleware.JWTAuth once the signature has been verified.
+type Claims struct {
+	Username string `json:"username"`
+	Role     string `json:"role"`
+	jwt.RegisteredClaims
+}
+
+// signingKey returns the HMAC key used to sign and verify tokens, read
+// from JWT_SIGNING_KEY. A development fallback is used when unset so the
+// service still boots locally, but this must be overridden in production.
+func signingKey() []byte {
+	if key := os.Getenv("JWT_SIGNING_KEY"); key != "" {
+		return []byte(key)
+	}
+	return []byte("dev-only-signing-key")
+}
+
+// tokenTTL returns how long an issued token is valid for, read from
+// JWT_TOKEN_TTL_MINUTES, defaulting to 24 hours.
+func tokenTTL() time.Duration {
+	if raw := os.Getenv("JWT_TOKEN_TTL_MINUTES"); raw != "" {
+		if minutes, err := strconv.Atoi(raw); err == nil {
+			return time.Duration(minutes) * time.Minute
+		}
+	}
+	return 24 * time.Hour
+}
+
+// IssueToken signs and returns a JWT for the given user.
+func IssueToken(u *User) (string, error) {
+	claims := Claims{
+		Username: u.Username,
+		Role:     u.Role,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(tokenTTL())),
+			IssuedAt:  jwt.NewNumericDate(time.Now()),
+			Subject:   u.Username,
+		},
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	signed, err := token.SignedString(signingKey())
+	if err != nil {
+		return "", fmt.Errorf("unable to sign token: %+v", err)
+	}
+	return signed, nil
+}
+
+// ParseToken validates a signed token and returns its claims.
+func ParseToken(raw string) (*Claims, error) {
+	claims := &Claims{}
+	token, err := jwt.ParseWithClaims(raw, claims, func(t *jwt.Token) (interface{}, error) {
+		return signingKey(), nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse token: %+v", err)
+	}
+	if !token.Valid {
+		return nil, fmt.Errorf("token is not valid")
+	}
+	return claims, nil
+}
@@ -0,0 +1,77 @@
+package posts
+
+import (
+	"strings"
+	"sync"
+)
+
+// Index is a simple in-memory inverted index over post titles and bodies,
+// used to back GET /api/search. It trades recall (no stemming, no
+// fuzziness) for a dependency-free implementation that's easy to rebuild.
+type Index struct {
+	mu    sync.RWMutex
+	terms map[string]map[string]struct{} // term -> set of slugs
+}
+
+// NewIndex returns an empty Index.
+func NewIndex() *Index {
+	return &Index{terms: make(map[string]map[string]struct{})}
+}
+
+// Add tokenizes a post's title and body and adds it to the index.
+func (idx *Index) Add(p *Post) {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+
+	for _, term := range tokenize(p.Title + " " + p.Body) {
+		if idx.terms[term] == nil {
+			idx.terms[term] = make(map[string]struct{})
+		}
+		idx.terms[term][p.Slug] = struct{}{}
+	}
+}
+
+// Remove drops a post's tokens from the index. It is safe to call even if
+// the post was never indexed.
+func (idx *Index) Remove(p *Post) {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+
+	for _, term := range tokenize(p.Title + " " + p.Body) {
+		delete(idx.terms[term], p.Slug)
+	}
+}
+
+// Search returns the slugs of posts matching at least one term in the query,
+// ranked by how many of the query's terms they matched.
+func (idx *Index) Search(query string) []string {
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+
+	counts := make(map[string]int)
+	for _, term := range tokenize(query) {
+		for slug := range idx.terms[term] {
+			counts[slug]++
+		}
+	}
+
+	matches := make([]string, 0, len(counts))
+	for slug := range counts {
+		matches = append(matches, slug)
+	}
+
+	// stable-ish ordering: most matched terms first
+	for i := 1; i < len(matches); i++ {
+		for j := i; j > 0 && counts[matches[j]] > counts[matches[j-1]]; j-- {
+			matches[j], matches[j-1] = matches[j-1], matches[j]
+		}
+	}
+	return matches
+}
+
+func tokenize(text string) []string {
+	fields := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !('a' <= r && r <= 'z' || '0' <= r && r <= '9')
+	})
+	return fields
+}
@@ -0,0 +1,29 @@
+package posts
+
+import "errors"
+
+// ErrNotFound is returned by a Store when no post matches the given slug.
+var ErrNotFound = errors.New("post not found")
+
+// ErrSlugExists is returned by Store.Create when another post already has
+// the same slug.
+var ErrSlugExists = errors.New("post slug already exists")
+
+// ListOptions filters and paginates a call to Store.List.
+type ListOptions struct {
+	Tag     string
+	Page    int
+	PerPage int
+}
+
+// Store is the persistence contract for posts. Implementations are free to
+// back it with anything from an in-memory map to a real database, so long
+// as slugs are unique and List respects ListOptions.
+type Store interface {
+	Create(p *Post) error
+	Get(slug string) (*Post, error)
+	Update(slug string, p *Post) error
+	Delete(slug string) error
+	List(opts ListOptions) ([]*Post, int, error)
+	All() ([]*Post, error)
+}
@@ -0,0 +1,77 @@
+package main
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/tecian-software/tech-blog/internal/auth"
+	"github.com/tecian-software/tech-blog/internal/middleware"
+	"github.com/tecian-software/tech-blog/internal/posts"
+)
+
+func New() *gin.Engine {
+	// generate new instance of router engine
+	r := gin.Default()
+
+	// generate health check endpoint for application
+	r.GET("/health_check", func(ctx *gin.Context) {
+		log.Debug("received request for health check handler")
+		ctx.JSON(http.StatusOK, gin.H{"message": "Service is running"})
+	})
+
+	v1 := r.Group("/api/v1")
+
+	// add POST endpoint to mock functionality
+	v1.POST("/example", func(ctx *gin.Context) {
+		log.Debug("received request to execute sample endpoint")
+		var r struct {
+			X int `json:"x" binding:"required"`
+			Y int `json:"y" binding:"required"`
+		}
+		// parse request body and raise error if
+		// variables cannot be processed/parsed
+		if err := ctx.ShouldBind(&r); err != nil {
+			log.Error(fmt.Errorf("Unable to execute request: unable to parse request body. %+v", err))
+			ctx.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"message": "Invalid request body"})
+			return
+		}
+
+		ctx.JSON(http.StatusOK, gin.H{"result": r.X + r.Y})
+	})
+
+	// wire up authentication: registration and login are public, everything
+	// else behind JWTAuth attaches the caller to the context
+	authStore := auth.NewMemoryStore()
+	auth.NewHandler(authStore).Register(v1.Group("/auth"))
+
+	// wire up the blog subsystem: posts are persisted in-memory by default,
+	// so local runs don't require a database. Reads are public; writes
+	// require a valid token and are scoped to the caller's own posts.
+	postsStore := posts.NewMemoryStore()
+	postsHandler, err := posts.NewHandler(postsStore)
+	if err != nil {
+		log.Fatal(fmt.Errorf("Unable to start application: unable to initialize posts handler. %+v", err))
+	}
+
+	postsGroup := v1.Group("/posts")
+	postsGroup.GET("", postsHandler.List)
+	postsGroup.GET("/:slug", postsHandler.Get)
+
+	postsWrite := v1.Group("/posts", middleware.JWTAuth())
+	postsWrite.POST("", postsHandler.Create)
+	postsWrite.PUT("/:slug", postsHandler.Update)
+	postsWrite.DELETE("/:slug", postsHandler.Delete)
+
+	v1.GET("/search", postsHandler.Search)
+
+	return r
+}
+
+func main() {
+	// generate new server engine and start
+	router := New()
+	router.Run(fmt.Sprintf(":8080"))
+}
@@ -0,0 +1,87 @@
+package auth
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// Handler wires the authentication HTTP surface to a Store.
+type Handler struct {
+	store Store
+}
+
+// NewHandler returns a Handler backed by store.
+func NewHandler(store Store) *Handler {
+	return &Handler{store: store}
+}
+
+// Register mounts the auth routes on r.
+func (h *Handler) Register(r gin.IRouter) {
+	r.POST("/register", h.register)
+	r.POST("/login", h.login)
+}
+
+func (h *Handler) register(ctx *gin.Context) {
+	log.Debug("received request to register user")
+
+	var req RegisterRequest
+	if err := ctx.ShouldBind(&req); err != nil {
+		log.Error(fmt.Errorf("Unable to execute request: unable to parse request body. %+v", err))
+		ctx.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"message": "Invalid request body"})
+		return
+	}
+
+	hash, err := bcrypt.GenerateFromPassword([]byte(req.Password), bcrypt.DefaultCost)
+	if err != nil {
+		log.Error(fmt.Errorf("Unable to execute request: unable to hash password. %+v", err))
+		ctx.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"message": "Unable to register user"})
+		return
+	}
+
+	user := &User{Username: req.Username, PasswordHash: string(hash), Role: RoleAuthor}
+	if err := h.store.Create(user); err == ErrUserExists {
+		ctx.AbortWithStatusJSON(http.StatusConflict, gin.H{"message": "Username already taken"})
+		return
+	} else if err != nil {
+		log.Error(fmt.Errorf("Unable to execute request: unable to create user. %+v", err))
+		ctx.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"message": "Unable to register user"})
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, gin.H{"username": user.Username, "role": user.Role})
+}
+
+func (h *Handler) login(ctx *gin.Context) {
+	log.Debug("received request to log in user")
+
+	var req LoginRequest
+	if err := ctx.ShouldBind(&req); err != nil {
+		log.Error(fmt.Errorf("Unable to execute request: unable to parse request body. %+v", err))
+		ctx.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"message": "Invalid request body"})
+		return
+	}
+
+	user, err := h.store.Get(req.Username)
+	if err != nil {
+		ctx.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"message": "Invalid username or password"})
+		return
+	}
+
+	if err := bcrypt.CompareHashAndPassword([]byte(user.PasswordHash), []byte(req.Password)); err != nil {
+		ctx.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"message": "Invalid username or password"})
+		return
+	}
+
+	token, err := IssueToken(user)
+	if err != nil {
+		log.Error(fmt.Errorf("Unable to execute request: unable to issue token. %+v", err))
+		ctx.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"message": "Unable to log in"})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"token": token})
+}
@@ -0,0 +1,60 @@
+package middleware
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+var (
+	requestCount = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "http_requests_total",
+			Help: "Total number of HTTP requests, labeled by route and status.",
+		},
+		[]string{"route", "status"},
+	)
+
+	requestLatency = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "http_request_duration_seconds",
+			Help:    "HTTP request latency in seconds, labeled by route and status.",
+			Buckets: prometheus.DefBuckets,
+		},
+		[]string{"route", "status"},
+	)
+)
+
+func init() {
+	prometheus.MustRegister(requestCount, requestLatency)
+}
+
+// Metrics records a request count and latency observation per route and
+// status code. It should be mounted before routes are matched so that
+// ctx.FullPath() is populated by the time the handler returns.
+func Metrics() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		start := time.Now()
+		ctx.Next()
+
+		route := ctx.FullPath()
+		if route == "" {
+			route = "unmatched"
+		}
+		status := strconv.Itoa(ctx.Writer.Status())
+
+		requestCount.WithLabelValues(route, status).Inc()
+		requestLatency.WithLabelValues(route, status).Observe(time.Since(start).Seconds())
+	}
+}
+
+// MetricsHandler exposes the registered metrics for scraping at /metrics.
+func MetricsHandler() gin.HandlerFunc {
+	handler := promhttp.Handler()
+	return func(ctx *gin.Context) {
+		handler.ServeHTTP(ctx.Writer, ctx.Request)
+	}
+}
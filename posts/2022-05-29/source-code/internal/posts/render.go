@@ -0,0 +1,18 @@
+package posts
+
+import (
+	"github.com/microcosm-cc/bluemonday"
+	blackfriday "github.com/russross/blackfriday/v2"
+)
+
+// sanitizer strips anything beyond the UGC policy (no inline scripts,
+// no on* attributes) so rendered post bodies are safe to serve as-is.
+var sanitizer = bluemonday.UGCPolicy()
+
+// RenderHTML converts a post's Markdown body to sanitized HTML. It is
+// called on read so the stored record always stays the Markdown source of
+// truth.
+func RenderHTML(markdown string) string {
+	unsafe := blackfriday.Run([]byte(markdown))
+	return string(sanitizer.SanitizeBytes(unsafe))
+}
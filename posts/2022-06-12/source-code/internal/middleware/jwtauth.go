@@ -0,0 +1,39 @@
+package middleware
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/tecian-software/tech-blog/internal/auth"
+)
+
+// JWTAuth validates the Authorization: Bearer header on every request,
+// attaching the resolved username and role to the context on success and
+// short-circuiting with 401 otherwise.
+func JWTAuth() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		header := ctx.GetHeader("Authorization")
+		if header == "" {
+			ctx.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"message": "Missing Authorization header"})
+			return
+		}
+
+		parts := strings.SplitN(header, " ", 2)
+		if len(parts) != 2 || parts[0] != "Bearer" {
+			ctx.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"message": "Authorization header must be a bearer token"})
+			return
+		}
+
+		claims, err := auth.ParseToken(parts[1])
+		if err != nil {
+			ctx.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"message": "Invalid or expired token"})
+			return
+		}
+
+		ctx.Set("user", claims.Username)
+		ctx.Set("role", claims.Role)
+		ctx.Next()
+	}
+}
@@ -0,0 +1,142 @@
+package posts
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+// MemoryStore is a thread-safe, in-memory Store implementation. It is the
+// default for local development and tests; SQLiteStore should be used for
+// anything that needs to survive a restart.
+type MemoryStore struct {
+	mu    sync.RWMutex
+	posts map[string]*Post
+}
+
+// NewMemoryStore returns an empty, ready-to-use MemoryStore.
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{posts: make(map[string]*Post)}
+}
+
+func (s *MemoryStore) Create(p *Post) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.posts[p.Slug]; ok {
+		return ErrSlugExists
+	}
+
+	now := time.Now().UTC()
+	p.CreatedAt = now
+	p.UpdatedAt = now
+	s.posts[p.Slug] = p
+	return nil
+}
+
+func (s *MemoryStore) Get(slug string) (*Post, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	p, ok := s.posts[slug]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	return p, nil
+}
+
+func (s *MemoryStore) Update(slug string, p *Post) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	existing, ok := s.posts[slug]
+	if !ok {
+		return ErrNotFound
+	}
+
+	// Replace the map entry with a new *Post rather than mutating existing
+	// in place: callers that got existing's pointer from Get/List/All while
+	// unlocked must keep seeing a consistent, unchanging value.
+	s.posts[slug] = &Post{
+		ID:        existing.ID,
+		Slug:      existing.Slug,
+		Title:     p.Title,
+		Body:      p.Body,
+		Tags:      p.Tags,
+		Author:    existing.Author,
+		CreatedAt: existing.CreatedAt,
+		UpdatedAt: time.Now().UTC(),
+	}
+	return nil
+}
+
+func (s *MemoryStore) Delete(slug string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.posts[slug]; !ok {
+		return ErrNotFound
+	}
+	delete(s.posts, slug)
+	return nil
+}
+
+func (s *MemoryStore) List(opts ListOptions) ([]*Post, int, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	matched := make([]*Post, 0, len(s.posts))
+	for _, p := range s.posts {
+		if opts.Tag != "" && !hasTag(p.Tags, opts.Tag) {
+			continue
+		}
+		matched = append(matched, p)
+	}
+
+	sort.Slice(matched, func(i, j int) bool {
+		return matched[i].CreatedAt.After(matched[j].CreatedAt)
+	})
+
+	total := len(matched)
+	return paginate(matched, opts.Page, opts.PerPage), total, nil
+}
+
+func (s *MemoryStore) All() ([]*Post, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	all := make([]*Post, 0, len(s.posts))
+	for _, p := range s.posts {
+		all = append(all, p)
+	}
+	return all, nil
+}
+
+func hasTag(tags []string, tag string) bool {
+	for _, t := range tags {
+		if t == tag {
+			return true
+		}
+	}
+	return false
+}
+
+func paginate(posts []*Post, page, perPage int) []*Post {
+	if page < 1 {
+		page = 1
+	}
+	if perPage < 1 {
+		perPage = 20
+	}
+
+	start := (page - 1) * perPage
+	if start >= len(posts) {
+		return []*Post{}
+	}
+
+	end := start + perPage
+	if end > len(posts) {
+		end = len(posts)
+	}
+	return posts[start:end]
+}
@@ -0,0 +1,88 @@
+package server
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/tecian-software/tech-blog/internal/config"
+)
+
+// Run starts an *http.Server wrapping handler according to cfg and blocks
+// until it exits: either because the server failed to start, or because
+// SIGINT/SIGTERM was received and a graceful shutdown completed. It
+// dispatches to plain HTTP, TLS (with HTTP/2 enabled), or a unix socket
+// depending on which fields of cfg are set.
+func Run(cfg config.Server, handler http.Handler) error {
+	srv := &http.Server{
+		Addr:         cfg.Addr,
+		Handler:      handler,
+		ReadTimeout:  cfg.ReadTimeout,
+		WriteTimeout: cfg.WriteTimeout,
+		IdleTimeout:  cfg.IdleTimeout,
+	}
+
+	serveErr := make(chan error, 1)
+	go func() {
+		serveErr <- listenAndServe(srv, cfg)
+	}()
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	select {
+	case err := <-serveErr:
+		if err != nil && err != http.ErrServerClosed {
+			return fmt.Errorf("unable to start server: %+v", err)
+		}
+		return nil
+	case <-ctx.Done():
+		log.Info("received shutdown signal, draining in-flight requests")
+	}
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), cfg.ShutdownTimeout)
+	defer cancel()
+
+	if err := srv.Shutdown(shutdownCtx); err != nil {
+		return fmt.Errorf("unable to shut down server gracefully: %+v", err)
+	}
+
+	log.Info("server shut down cleanly")
+	return nil
+}
+
+func listenAndServe(srv *http.Server, cfg config.Server) error {
+	switch {
+	case cfg.UnixSocket != "":
+		return serveUnix(srv, cfg.UnixSocket)
+	case cfg.TLSCertFile != "" && cfg.TLSKeyFile != "":
+		srv.TLSConfig = &tls.Config{NextProtos: []string{"h2", "http/1.1"}}
+		return srv.ListenAndServeTLS(cfg.TLSCertFile, cfg.TLSKeyFile)
+	default:
+		return srv.ListenAndServe()
+	}
+}
+
+// serveUnix listens on a unix socket instead of a TCP port, for
+// deployments sitting behind a reverse proxy such as nginx. Any stale
+// socket file left behind by a previous, uncleanly-terminated process is
+// removed first.
+func serveUnix(srv *http.Server, path string) error {
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("unable to remove stale unix socket: %+v", err)
+	}
+
+	listener, err := net.Listen("unix", path)
+	if err != nil {
+		return fmt.Errorf("unable to listen on unix socket: %+v", err)
+	}
+
+	return srv.Serve(listener)
+}
@@ -0,0 +1,100 @@
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+
+	"github.com/gin-gonic/gin"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/tecian-software/tech-blog/internal/auth"
+	"github.com/tecian-software/tech-blog/internal/config"
+	"github.com/tecian-software/tech-blog/internal/middleware"
+	"github.com/tecian-software/tech-blog/internal/posts"
+	"github.com/tecian-software/tech-blog/internal/server"
+)
+
+func New() *gin.Engine {
+	middleware.ConfigureLogging()
+
+	// generate new instance of router engine, replacing gin's default
+	// logger/recovery with our own structured, request-ID-aware logging
+	r := gin.New()
+	r.Use(middleware.RequestID())
+	r.Use(middleware.Logger())
+	r.Use(middleware.Metrics())
+	// Recovery goes last (closest to the handlers) so a panic is recovered
+	// before it unwinds past Logger/Metrics, letting their post-request
+	// logging and metrics recording still run.
+	r.Use(gin.Recovery())
+
+	// generate health check endpoint for application
+	r.GET("/health_check", func(ctx *gin.Context) {
+		log.Debug("received request for health check handler")
+		ctx.JSON(http.StatusOK, gin.H{"message": "Service is running"})
+	})
+
+	r.GET("/metrics", middleware.MetricsHandler())
+
+	v1 := r.Group("/api/v1")
+
+	// add POST endpoint to mock functionality
+	v1.POST("/example", func(ctx *gin.Context) {
+		log.Debug("received request to execute sample endpoint")
+		var r struct {
+			X int `json:"x" binding:"required"`
+			Y int `json:"y" binding:"required"`
+		}
+		// parse request body and raise error if
+		// variables cannot be processed/parsed
+		if err := ctx.ShouldBind(&r); err != nil {
+			log.Error(fmt.Errorf("Unable to execute request: unable to parse request body. %+v", err))
+			ctx.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"message": "Invalid request body"})
+			return
+		}
+
+		ctx.JSON(http.StatusOK, gin.H{"result": r.X + r.Y})
+	})
+
+	// wire up authentication: registration and login are public, everything
+	// else behind JWTAuth attaches the caller to the context
+	authStore := auth.NewMemoryStore()
+	auth.NewHandler(authStore).Register(v1.Group("/auth"))
+
+	// wire up the blog subsystem: posts are persisted in-memory by default,
+	// so local runs don't require a database. Reads are public; writes
+	// require a valid token and are scoped to the caller's own posts.
+	postsStore := posts.NewMemoryStore()
+	postsHandler, err := posts.NewHandler(postsStore)
+	if err != nil {
+		log.Fatal(fmt.Errorf("Unable to start application: unable to initialize posts handler. %+v", err))
+	}
+
+	postsGroup := v1.Group("/posts")
+	postsGroup.GET("", postsHandler.List)
+	postsGroup.GET("/:slug", postsHandler.Get)
+
+	postsWrite := v1.Group("/posts", middleware.JWTAuth())
+	postsWrite.POST("", postsHandler.Create)
+	postsWrite.PUT("/:slug", postsHandler.Update)
+	postsWrite.DELETE("/:slug", postsHandler.Delete)
+
+	v1.GET("/search", postsHandler.Search)
+
+	return r
+}
+
+func main() {
+	cfg, err := config.Load(os.Getenv("CONFIG_FILE"))
+	if err != nil {
+		log.Fatal(fmt.Errorf("Unable to start application: unable to load config. %+v", err))
+	}
+
+	// generate new router engine and serve it behind a configurable
+	// *http.Server that shuts down gracefully on SIGINT/SIGTERM
+	router := New()
+	if err := server.Run(cfg, router); err != nil {
+		log.Fatal(fmt.Errorf("Unable to start application: %+v", err))
+	}
+}
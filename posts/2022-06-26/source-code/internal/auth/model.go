@@ -0,0 +1,30 @@
+package auth
+
+import "time"
+
+// User is an author account. PasswordHash is never serialized to JSON.
+type User struct {
+	Username     string    `json:"username"`
+	PasswordHash string    `json:"-"`
+	Role         string    `json:"role"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+// Role constants used throughout the application. Everyone registers as
+// RoleAuthor; RoleAdmin is granted out of band.
+const (
+	RoleAuthor = "author"
+	RoleAdmin  = "admin"
+)
+
+// RegisterRequest is the expected payload for POST /auth/register.
+type RegisterRequest struct {
+	Username string `json:"username" binding:"required"`
+	Password string `json:"password" binding:"required"`
+}
+
+// LoginRequest is the expected payload for POST /auth/login.
+type LoginRequest struct {
+	Username string `json:"username" binding:"required"`
+	Password string `json:"password" binding:"required"`
+}
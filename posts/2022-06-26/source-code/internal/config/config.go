@@ -0,0 +1,73 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Server holds the knobs needed to run the application's HTTP server in
+// production: listen address, timeouts, optional TLS, and an optional
+// shutdown grace period.
+type Server struct {
+	Addr            string        `yaml:"addr"`
+	ReadTimeout     time.Duration `yaml:"read_timeout"`
+	WriteTimeout    time.Duration `yaml:"write_timeout"`
+	IdleTimeout     time.Duration `yaml:"idle_timeout"`
+	ShutdownTimeout time.Duration `yaml:"shutdown_timeout"`
+	TLSCertFile     string        `yaml:"tls_cert_file"`
+	TLSKeyFile      string        `yaml:"tls_key_file"`
+	UnixSocket      string        `yaml:"unix_socket"`
+}
+
+// defaults listens on :8080, like the single-file Gin server this replaces,
+// with 15s read/write, 60s idle and 10s shutdown timeouts.
+func defaults() Server {
+	return Server{
+		Addr:            ":8080",
+		ReadTimeout:     15 * time.Second,
+		WriteTimeout:    15 * time.Second,
+		IdleTimeout:     60 * time.Second,
+		ShutdownTimeout: 10 * time.Second,
+	}
+}
+
+// Load reads server configuration from the YAML file at path, if it
+// exists, then applies SERVER_-prefixed env var overrides on top. A
+// missing path is not an error: the defaults are used instead, so the
+// service still runs with no configuration present.
+func Load(path string) (Server, error) {
+	cfg := defaults()
+
+	if path != "" {
+		data, err := os.ReadFile(path)
+		if err != nil && !os.IsNotExist(err) {
+			return Server{}, fmt.Errorf("unable to read config file: %+v", err)
+		}
+		if err == nil {
+			if err := yaml.Unmarshal(data, &cfg); err != nil {
+				return Server{}, fmt.Errorf("unable to parse config file: %+v", err)
+			}
+		}
+	}
+
+	applyEnvOverrides(&cfg)
+	return cfg, nil
+}
+
+func applyEnvOverrides(cfg *Server) {
+	if addr := os.Getenv("SERVER_ADDR"); addr != "" {
+		cfg.Addr = addr
+	}
+	if cert := os.Getenv("SERVER_TLS_CERT_FILE"); cert != "" {
+		cfg.TLSCertFile = cert
+	}
+	if key := os.Getenv("SERVER_TLS_KEY_FILE"); key != "" {
+		cfg.TLSKeyFile = key
+	}
+	if socket := os.Getenv("SERVER_UNIX_SOCKET"); socket != "" {
+		cfg.UnixSocket = socket
+	}
+}
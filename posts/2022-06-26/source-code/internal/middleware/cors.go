@@ -0,0 +1,52 @@
+package middleware
+
+import (
+	"os"
+	"strings"
+	"time"
+
+	"github.com/gin-contrib/cors"
+	"github.com/gin-gonic/gin"
+)
+
+// CORSConfig holds the knobs for the CORS middleware, sourced from env
+// vars so allowed origins can differ per environment without a rebuild.
+type CORSConfig struct {
+	AllowOrigins     []string
+	AllowMethods     []string
+	AllowHeaders     []string
+	AllowCredentials bool
+	MaxAge           time.Duration
+}
+
+// CORSConfigFromEnv builds a CORSConfig from CORS_-prefixed env vars,
+// falling back to permissive defaults suitable for local development.
+//
+//	CORS_ALLOW_ORIGINS      comma-separated list (default: "*")
+//	CORS_ALLOW_CREDENTIALS  "true" to allow credentialed requests
+func CORSConfigFromEnv() CORSConfig {
+	origins := []string{"*"}
+	if raw := os.Getenv("CORS_ALLOW_ORIGINS"); raw != "" {
+		origins = strings.Split(raw, ",")
+	}
+
+	return CORSConfig{
+		AllowOrigins:     origins,
+		AllowMethods:     []string{"GET", "POST", "PUT", "DELETE", "OPTIONS"},
+		AllowHeaders:     []string{"Authorization", "Content-Type"},
+		AllowCredentials: os.Getenv("CORS_ALLOW_CREDENTIALS") == "true",
+		MaxAge:           12 * time.Hour,
+	}
+}
+
+// CORS returns a configured CORS handler suitable for mounting globally
+// with r.Use.
+func CORS(cfg CORSConfig) gin.HandlerFunc {
+	return cors.New(cors.Config{
+		AllowOrigins:     cfg.AllowOrigins,
+		AllowMethods:     cfg.AllowMethods,
+		AllowHeaders:     cfg.AllowHeaders,
+		AllowCredentials: cfg.AllowCredentials,
+		MaxAge:           cfg.MaxAge,
+	})
+}
@@ -0,0 +1,173 @@
+package posts
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/mattn/go-sqlite3"
+)
+
+// SQLiteStore is a Store backed by a local SQLite database, so posts
+// survive a process restart. main wires MemoryStore; SQLiteStore is
+// available to callers that construct it with NewSQLiteStore.
+type SQLiteStore struct {
+	db *sql.DB
+}
+
+// NewSQLiteStore opens (and migrates, if necessary) the SQLite database at
+// path and returns a ready-to-use SQLiteStore.
+func NewSQLiteStore(path string) (*SQLiteStore, error) {
+	db, err := sql.Open("sqlite3", path)
+	if err != nil {
+		return nil, fmt.Errorf("unable to open sqlite database: %+v", err)
+	}
+
+	const schema = `
+	CREATE TABLE IF NOT EXISTS posts (
+		slug       TEXT PRIMARY KEY,
+		title      TEXT NOT NULL,
+		body       TEXT NOT NULL,
+		tags       TEXT NOT NULL DEFAULT '',
+		author     TEXT NOT NULL DEFAULT '',
+		created_at DATETIME NOT NULL,
+		updated_at DATETIME NOT NULL
+	);`
+	if _, err := db.Exec(schema); err != nil {
+		return nil, fmt.Errorf("unable to migrate sqlite database: %+v", err)
+	}
+
+	return &SQLiteStore{db: db}, nil
+}
+
+func (s *SQLiteStore) Create(p *Post) error {
+	now := time.Now().UTC()
+	p.CreatedAt = now
+	p.UpdatedAt = now
+
+	_, err := s.db.Exec(
+		`INSERT INTO posts (slug, title, body, tags, author, created_at, updated_at)
+		 VALUES (?, ?, ?, ?, ?, ?, ?)`,
+		p.Slug, p.Title, p.Body, strings.Join(p.Tags, ","), p.Author, p.CreatedAt, p.UpdatedAt,
+	)
+	if err != nil {
+		var sqliteErr sqlite3.Error
+		if errors.As(err, &sqliteErr) && sqliteErr.Code == sqlite3.ErrConstraint {
+			return ErrSlugExists
+		}
+		return fmt.Errorf("unable to insert post: %+v", err)
+	}
+	return nil
+}
+
+func (s *SQLiteStore) Get(slug string) (*Post, error) {
+	row := s.db.QueryRow(
+		`SELECT slug, title, body, tags, author, created_at, updated_at
+		 FROM posts WHERE slug = ?`, slug,
+	)
+	return scanPost(row)
+}
+
+func (s *SQLiteStore) Update(slug string, p *Post) error {
+	res, err := s.db.Exec(
+		`UPDATE posts SET title = ?, body = ?, tags = ?, updated_at = ? WHERE slug = ?`,
+		p.Title, p.Body, strings.Join(p.Tags, ","), time.Now().UTC(), slug,
+	)
+	if err != nil {
+		return fmt.Errorf("unable to update post: %+v", err)
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("unable to determine update result: %+v", err)
+	}
+	if affected == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+func (s *SQLiteStore) Delete(slug string) error {
+	res, err := s.db.Exec(`DELETE FROM posts WHERE slug = ?`, slug)
+	if err != nil {
+		return fmt.Errorf("unable to delete post: %+v", err)
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("unable to determine delete result: %+v", err)
+	}
+	if affected == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+func (s *SQLiteStore) List(opts ListOptions) ([]*Post, int, error) {
+	all, err := s.All()
+	if err != nil {
+		return nil, 0, err
+	}
+
+	matched := make([]*Post, 0, len(all))
+	for _, p := range all {
+		if opts.Tag != "" && !hasTag(p.Tags, opts.Tag) {
+			continue
+		}
+		matched = append(matched, p)
+	}
+
+	return paginate(matched, opts.Page, opts.PerPage), len(matched), nil
+}
+
+func (s *SQLiteStore) All() ([]*Post, error) {
+	rows, err := s.db.Query(
+		`SELECT slug, title, body, tags, author, created_at, updated_at
+		 FROM posts ORDER BY created_at DESC`,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to query posts: %+v", err)
+	}
+	defer rows.Close()
+
+	var all []*Post
+	for rows.Next() {
+		p, err := scanPostRows(rows)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, p)
+	}
+	return all, nil
+}
+
+type scanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanPost(row scanner) (*Post, error) {
+	return scanInto(row)
+}
+
+func scanPostRows(rows *sql.Rows) (*Post, error) {
+	return scanInto(rows)
+}
+
+func scanInto(s scanner) (*Post, error) {
+	var p Post
+	var tags string
+
+	if err := s.Scan(&p.Slug, &p.Title, &p.Body, &tags, &p.Author, &p.CreatedAt, &p.UpdatedAt); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("unable to scan post row: %+v", err)
+	}
+
+	if tags != "" {
+		p.Tags = strings.Split(tags, ",")
+	}
+	return &p, nil
+}
@@ -0,0 +1,279 @@
+// Package docs holds the API spec served at /swagger/doc.json, in the
+// layout `swag init` emits for the swaggo annotations in main.go and the
+// posts and auth handlers. The spec names main.exampleRequest and
+// main.errorResponse schemas for the example body and error responses;
+// no Go types carry those names, and the annotations describe those
+// bodies as an inline object and gin.H. swag only emits
+// Swagger 2.0 (the "swagger": "2.0" field below is accurate, not a typo) —
+// there is no OpenAPI 3 output to target with the current tooling.
+package docs
+
+import "github.com/swaggo/swag"
+
+const docTemplate = `{
+    "schemes": {{ marshal .Schemes }},
+    "swagger": "2.0",
+    "info": {
+        "description": "{{escape .Description}}",
+        "title": "{{.Title}}",
+        "contact": {},
+        "version": "{{.Version}}"
+    },
+    "host": "{{.Host}}",
+    "basePath": "{{.BasePath}}",
+    "paths": {
+        "/health_check": {
+            "get": {
+                "description": "reports whether the service is up",
+                "produces": ["application/json"],
+                "tags": ["meta"],
+                "summary": "Health check",
+                "responses": {
+                    "200": { "description": "OK" }
+                }
+            }
+        },
+        "/api/v1/example": {
+            "post": {
+                "description": "adds two integers, used to smoke-test request binding",
+                "consumes": ["application/json"],
+                "produces": ["application/json"],
+                "tags": ["meta"],
+                "summary": "Example adder",
+                "parameters": [
+                    {
+                        "description": "operands",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": { "$ref": "#/definitions/main.exampleRequest" }
+                    }
+                ],
+                "responses": {
+                    "200": { "description": "OK" },
+                    "400": { "description": "Bad Request", "schema": { "$ref": "#/definitions/main.errorResponse" } }
+                }
+            }
+        },
+        "/api/v1/posts": {
+            "get": {
+                "description": "lists posts, optionally filtered by tag and paginated",
+                "produces": ["application/json"],
+                "tags": ["posts"],
+                "summary": "List posts",
+                "parameters": [
+                    { "type": "string", "name": "tag", "in": "query" },
+                    { "type": "integer", "name": "page", "in": "query" },
+                    { "type": "integer", "name": "per_page", "in": "query" }
+                ],
+                "responses": {
+                    "200": { "description": "OK" }
+                }
+            },
+            "post": {
+                "description": "creates a post owned by the authenticated caller",
+                "security": [{ "BearerAuth": [] }],
+                "consumes": ["application/json"],
+                "produces": ["application/json"],
+                "tags": ["posts"],
+                "summary": "Create post",
+                "parameters": [
+                    {
+                        "description": "post",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": { "$ref": "#/definitions/posts.CreatePostRequest" }
+                    }
+                ],
+                "responses": {
+                    "201": { "description": "Created" },
+                    "400": { "description": "Bad Request", "schema": { "$ref": "#/definitions/main.errorResponse" } },
+                    "401": { "description": "Unauthorized", "schema": { "$ref": "#/definitions/main.errorResponse" } }
+                }
+            }
+        },
+        "/api/v1/posts/{slug}": {
+            "get": {
+                "description": "fetches a post by slug, rendered to sanitized HTML",
+                "produces": ["application/json"],
+                "tags": ["posts"],
+                "summary": "Get post",
+                "parameters": [
+                    { "type": "string", "name": "slug", "in": "path", "required": true }
+                ],
+                "responses": {
+                    "200": { "description": "OK" },
+                    "404": { "description": "Not Found", "schema": { "$ref": "#/definitions/main.errorResponse" } }
+                }
+            },
+            "put": {
+                "description": "updates a post owned by the authenticated caller",
+                "security": [{ "BearerAuth": [] }],
+                "consumes": ["application/json"],
+                "produces": ["application/json"],
+                "tags": ["posts"],
+                "summary": "Update post",
+                "parameters": [
+                    { "type": "string", "name": "slug", "in": "path", "required": true },
+                    {
+                        "description": "post",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": { "$ref": "#/definitions/posts.UpdatePostRequest" }
+                    }
+                ],
+                "responses": {
+                    "200": { "description": "OK" },
+                    "403": { "description": "Forbidden", "schema": { "$ref": "#/definitions/main.errorResponse" } },
+                    "404": { "description": "Not Found", "schema": { "$ref": "#/definitions/main.errorResponse" } }
+                }
+            },
+            "delete": {
+                "description": "deletes a post owned by the authenticated caller",
+                "security": [{ "BearerAuth": [] }],
+                "produces": ["application/json"],
+                "tags": ["posts"],
+                "summary": "Delete post",
+                "parameters": [
+                    { "type": "string", "name": "slug", "in": "path", "required": true }
+                ],
+                "responses": {
+                    "200": { "description": "OK" },
+                    "403": { "description": "Forbidden", "schema": { "$ref": "#/definitions/main.errorResponse" } },
+                    "404": { "description": "Not Found", "schema": { "$ref": "#/definitions/main.errorResponse" } }
+                }
+            }
+        },
+        "/api/v1/search": {
+            "get": {
+                "description": "full-text search over post titles and bodies",
+                "produces": ["application/json"],
+                "tags": ["posts"],
+                "summary": "Search posts",
+                "parameters": [
+                    { "type": "string", "name": "q", "in": "query", "required": true }
+                ],
+                "responses": {
+                    "200": { "description": "OK" },
+                    "400": { "description": "Bad Request", "schema": { "$ref": "#/definitions/main.errorResponse" } }
+                }
+            }
+        },
+        "/api/v1/auth/register": {
+            "post": {
+                "description": "registers a new author account",
+                "consumes": ["application/json"],
+                "produces": ["application/json"],
+                "tags": ["auth"],
+                "summary": "Register",
+                "parameters": [
+                    {
+                        "description": "credentials",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": { "$ref": "#/definitions/auth.RegisterRequest" }
+                    }
+                ],
+                "responses": {
+                    "201": { "description": "Created" },
+                    "409": { "description": "Conflict", "schema": { "$ref": "#/definitions/main.errorResponse" } }
+                }
+            }
+        },
+        "/api/v1/auth/login": {
+            "post": {
+                "description": "exchanges credentials for a signed JWT",
+                "consumes": ["application/json"],
+                "produces": ["application/json"],
+                "tags": ["auth"],
+                "summary": "Login",
+                "parameters": [
+                    {
+                        "description": "credentials",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": { "$ref": "#/definitions/auth.LoginRequest" }
+                    }
+                ],
+                "responses": {
+                    "200": { "description": "OK" },
+                    "401": { "description": "Unauthorized", "schema": { "$ref": "#/definitions/main.errorResponse" } }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "main.exampleRequest": {
+            "type": "object",
+            "properties": {
+                "x": { "type": "integer" },
+                "y": { "type": "integer" }
+            }
+        },
+        "main.errorResponse": {
+            "type": "object",
+            "properties": {
+                "message": { "type": "string" }
+            }
+        },
+        "posts.CreatePostRequest": {
+            "type": "object",
+            "properties": {
+                "title": { "type": "string" },
+                "body": { "type": "string" },
+                "tags": { "type": "array", "items": { "type": "string" } }
+            }
+        },
+        "posts.UpdatePostRequest": {
+            "type": "object",
+            "properties": {
+                "title": { "type": "string" },
+                "body": { "type": "string" },
+                "tags": { "type": "array", "items": { "type": "string" } }
+            }
+        },
+        "auth.RegisterRequest": {
+            "type": "object",
+            "properties": {
+                "username": { "type": "string" },
+                "password": { "type": "string" }
+            }
+        },
+        "auth.LoginRequest": {
+            "type": "object",
+            "properties": {
+                "username": { "type": "string" },
+                "password": { "type": "string" }
+            }
+        }
+    },
+    "securityDefinitions": {
+        "BearerAuth": {
+            "type": "apiKey",
+            "name": "Authorization",
+            "in": "header"
+        }
+    }
+}`
+
+// SwaggerInfo holds exported Swagger Info so clients can modify it.
+var SwaggerInfo = &swag.Spec{
+	Version:          "1.0",
+	Host:             "",
+	BasePath:         "/",
+	Schemes:          []string{},
+	Title:            "tech-blog API",
+	Description:      "Blog content, authentication, and operational endpoints for tech-blog.",
+	InfoInstanceName: "swagger",
+	SwaggerTemplate:  docTemplate,
+	LeftDelim:        "{{",
+	RightDelim:       "}}",
+}
+
+func init() {
+	swag.Register(SwaggerInfo.InstanceName(), SwaggerInfo)
+}
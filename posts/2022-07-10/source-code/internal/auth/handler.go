@@ -0,0 +1,113 @@
+package auth
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// Handler wires the authentication HTTP surface to a Store.
+type Handler struct {
+	store Store
+}
+
+// NewHandler returns a Handler backed by store.
+func NewHandler(store Store) *Handler {
+	return &Handler{store: store}
+}
+
+// Register mounts the auth routes on r. loginMiddleware is applied only
+// to /login, so callers can layer a tighter rate limit on it than the
+// rest of the API without affecting /register.
+func (h *Handler) Register(r gin.IRouter, loginMiddleware ...gin.HandlerFunc) {
+	r.POST("/register", h.register)
+
+	loginHandlers := append(append([]gin.HandlerFunc{}, loginMiddleware...), h.login)
+	r.POST("/login", loginHandlers...)
+}
+
+// register godoc
+//
+//	@Summary		Register
+//	@Description	registers a new author account
+//	@Tags			auth
+//	@Accept			json
+//	@Produce		json
+//	@Param			body	body		RegisterRequest	true	"credentials"
+//	@Success		201	{object}	gin.H
+//	@Failure		409	{object}	gin.H
+//	@Router			/api/v1/auth/register [post]
+func (h *Handler) register(ctx *gin.Context) {
+	log.Debug("received request to register user")
+
+	var req RegisterRequest
+	if err := ctx.ShouldBind(&req); err != nil {
+		log.Error(fmt.Errorf("Unable to execute request: unable to parse request body. %+v", err))
+		ctx.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"message": "Invalid request body"})
+		return
+	}
+
+	hash, err := bcrypt.GenerateFromPassword([]byte(req.Password), bcrypt.DefaultCost)
+	if err != nil {
+		log.Error(fmt.Errorf("Unable to execute request: unable to hash password. %+v", err))
+		ctx.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"message": "Unable to register user"})
+		return
+	}
+
+	user := &User{Username: req.Username, PasswordHash: string(hash), Role: RoleAuthor}
+	if err := h.store.Create(user); err == ErrUserExists {
+		ctx.AbortWithStatusJSON(http.StatusConflict, gin.H{"message": "Username already taken"})
+		return
+	} else if err != nil {
+		log.Error(fmt.Errorf("Unable to execute request: unable to create user. %+v", err))
+		ctx.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"message": "Unable to register user"})
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, gin.H{"username": user.Username, "role": user.Role})
+}
+
+// login godoc
+//
+//	@Summary		Login
+//	@Description	exchanges credentials for a signed JWT
+//	@Tags			auth
+//	@Accept			json
+//	@Produce		json
+//	@Param			body	body		LoginRequest	true	"credentials"
+//	@Success		200	{object}	gin.H
+//	@Failure		401	{object}	gin.H
+//	@Router			/api/v1/auth/login [post]
+func (h *Handler) login(ctx *gin.Context) {
+	log.Debug("received request to log in user")
+
+	var req LoginRequest
+	if err := ctx.ShouldBind(&req); err != nil {
+		log.Error(fmt.Errorf("Unable to execute request: unable to parse request body. %+v", err))
+		ctx.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"message": "Invalid request body"})
+		return
+	}
+
+	user, err := h.store.Get(req.Username)
+	if err != nil {
+		ctx.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"message": "Invalid username or password"})
+		return
+	}
+
+	if err := bcrypt.CompareHashAndPassword([]byte(user.PasswordHash), []byte(req.Password)); err != nil {
+		ctx.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"message": "Invalid username or password"})
+		return
+	}
+
+	token, err := IssueToken(user)
+	if err != nil {
+		log.Error(fmt.Errorf("Unable to execute request: unable to issue token. %+v", err))
+		ctx.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"message": "Unable to log in"})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"token": token})
+}
@@ -0,0 +1,51 @@
+package auth
+
+import (
+	"errors"
+	"sync"
+)
+
+// ErrUserExists is returned by Store.Create when the username is taken.
+var ErrUserExists = errors.New("user already exists")
+
+// ErrUserNotFound is returned by Store.Get when no user matches.
+var ErrUserNotFound = errors.New("user not found")
+
+// Store is the persistence contract for user accounts.
+type Store interface {
+	Create(u *User) error
+	Get(username string) (*User, error)
+}
+
+// MemoryStore is a thread-safe, in-memory Store implementation.
+type MemoryStore struct {
+	mu    sync.RWMutex
+	users map[string]*User
+}
+
+// NewMemoryStore returns an empty, ready-to-use MemoryStore.
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{users: make(map[string]*User)}
+}
+
+func (s *MemoryStore) Create(u *User) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.users[u.Username]; ok {
+		return ErrUserExists
+	}
+	s.users[u.Username] = u
+	return nil
+}
+
+func (s *MemoryStore) Get(username string) (*User, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	u, ok := s.users[username]
+	if !ok {
+		return nil, ErrUserNotFound
+	}
+	return u, nil
+}
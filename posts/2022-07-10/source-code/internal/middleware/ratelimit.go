@@ -0,0 +1,59 @@
+package middleware
+
+import (
+	"net/http"
+	"strconv"
+	"sync"
+
+	"github.com/gin-gonic/gin"
+	"golang.org/x/time/rate"
+)
+
+// RateLimitConfig controls a single RateLimit middleware instance: rps is
+// the sustained rate and burst the number of requests allowed to exceed
+// it momentarily.
+type RateLimitConfig struct {
+	RPS   rate.Limit
+	Burst int
+}
+
+// DefaultRateLimit applies to the API as a whole.
+var DefaultRateLimit = RateLimitConfig{RPS: 10, Burst: 20}
+
+// LoginRateLimit is a tighter override for /auth/login, where brute-force
+// guessing is the concern rather than ordinary traffic bursts.
+var LoginRateLimit = RateLimitConfig{RPS: 1, Burst: 5}
+
+// RateLimit returns a token-bucket limiter keyed by client IP, configured
+// by cfg. Requests beyond the bucket's capacity get a 429 with a
+// Retry-After header instead of being served.
+func RateLimit(cfg RateLimitConfig) gin.HandlerFunc {
+	var mu sync.Mutex
+	limiters := make(map[string]*rate.Limiter)
+
+	limiterFor := func(ip string) *rate.Limiter {
+		mu.Lock()
+		defer mu.Unlock()
+
+		l, ok := limiters[ip]
+		if !ok {
+			l = rate.NewLimiter(cfg.RPS, cfg.Burst)
+			limiters[ip] = l
+		}
+		return l
+	}
+
+	return func(ctx *gin.Context) {
+		l := limiterFor(ctx.ClientIP())
+		if !l.Allow() {
+			retryAfter := int(1 / float64(cfg.RPS))
+			if retryAfter < 1 {
+				retryAfter = 1
+			}
+			ctx.Header("Retry-After", strconv.Itoa(retryAfter))
+			ctx.AbortWithStatusJSON(http.StatusTooManyRequests, gin.H{"message": "Rate limit exceeded"})
+			return
+		}
+		ctx.Next()
+	}
+}
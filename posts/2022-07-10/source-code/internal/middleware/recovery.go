@@ -0,0 +1,25 @@
+package middleware
+
+import (
+	"fmt"
+	"net/http"
+	"runtime/debug"
+
+	"github.com/gin-gonic/gin"
+	log "github.com/sirupsen/logrus"
+)
+
+// Recovery replaces gin's default panic recovery, which writes a plain
+// text response, with one that logs the panic and stack trace via
+// logrus and returns the same JSON error envelope as the rest of the API.
+func Recovery() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				log.Error(fmt.Errorf("Unable to execute request: recovered from panic: %+v\n%s", recovered, debug.Stack()))
+				ctx.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"message": "Internal server error"})
+			}
+		}()
+		ctx.Next()
+	}
+}
@@ -0,0 +1,23 @@
+package middleware
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// RequestIDHeader is the response header that echoes back the ID
+// generated by RequestID, so clients can correlate a response with the
+// access log line that was written for it.
+const RequestIDHeader = "X-Request-ID"
+
+// RequestID generates a UUID for every request, attaches it to the
+// context as "request_id" for downstream middleware (Logger in
+// particular), and echoes it back on the response.
+func RequestID() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		id := uuid.NewString()
+		ctx.Set("request_id", id)
+		ctx.Header(RequestIDHeader, id)
+		ctx.Next()
+	}
+}
@@ -0,0 +1,298 @@
+package posts
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	log "github.com/sirupsen/logrus"
+)
+
+// Handler wires the blog's HTTP surface to a Store and a search Index.
+type Handler struct {
+	store Store
+	index *Index
+}
+
+// NewHandler returns a Handler backed by store, reindexing anything store
+// already holds so search works immediately on startup.
+func NewHandler(store Store) (*Handler, error) {
+	h := &Handler{store: store, index: NewIndex()}
+
+	existing, err := store.All()
+	if err != nil {
+		return nil, fmt.Errorf("unable to build search index: %+v", err)
+	}
+	for _, p := range existing {
+		h.index.Add(p)
+	}
+
+	return h, nil
+}
+
+// List godoc
+//
+//	@Summary		List posts
+//	@Description	lists posts, optionally filtered by tag and paginated
+//	@Tags			posts
+//	@Produce		json
+//	@Param			tag			query		string	false	"filter by tag"
+//	@Param			page		query		int		false	"page number"
+//	@Param			per_page	query		int		false	"page size"
+//	@Success		200	{object}	gin.H
+//	@Router			/api/v1/posts [get]
+func (h *Handler) List(ctx *gin.Context) {
+	page, _ := strconv.Atoi(ctx.Query("page"))
+	perPage, _ := strconv.Atoi(ctx.Query("per_page"))
+
+	results, total, err := h.store.List(ListOptions{
+		Tag:     ctx.Query("tag"),
+		Page:    page,
+		PerPage: perPage,
+	})
+	if err != nil {
+		log.Error(fmt.Errorf("Unable to execute request: unable to list posts. %+v", err))
+		ctx.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"message": "Unable to list posts"})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"posts": results, "total": total})
+}
+
+// Get godoc
+//
+//	@Summary		Get post
+//	@Description	fetches a post by slug, rendered to sanitized HTML
+//	@Tags			posts
+//	@Produce		json
+//	@Param			slug	path		string	true	"post slug"
+//	@Success		200	{object}	gin.H
+//	@Failure		404	{object}	gin.H
+//	@Router			/api/v1/posts/{slug} [get]
+func (h *Handler) Get(ctx *gin.Context) {
+	p, err := h.store.Get(ctx.Param("slug"))
+	if err == ErrNotFound {
+		ctx.AbortWithStatusJSON(http.StatusNotFound, gin.H{"message": "Post not found"})
+		return
+	}
+	if err != nil {
+		log.Error(fmt.Errorf("Unable to execute request: unable to fetch post. %+v", err))
+		ctx.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"message": "Unable to fetch post"})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"post": p, "html": RenderHTML(p.Body)})
+}
+
+// Create godoc
+//
+//	@Summary		Create post
+//	@Description	creates a post owned by the authenticated caller
+//	@Tags			posts
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			body	body		CreatePostRequest	true	"post"
+//	@Success		201	{object}	gin.H
+//	@Failure		400	{object}	gin.H
+//	@Failure		401	{object}	gin.H
+//	@Router			/api/v1/posts [post]
+func (h *Handler) Create(ctx *gin.Context) {
+	log.Debug("received request to create post")
+
+	var req CreatePostRequest
+	if err := ctx.ShouldBind(&req); err != nil {
+		log.Error(fmt.Errorf("Unable to execute request: unable to parse request body. %+v", err))
+		ctx.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"message": "Invalid request body"})
+		return
+	}
+
+	p := &Post{
+		Slug:  slugify(req.Title),
+		Title: req.Title,
+		Body:  req.Body,
+		Tags:  req.Tags,
+	}
+	if author, ok := ctx.Get("user"); ok {
+		if username, ok := author.(string); ok {
+			p.Author = username
+		}
+	}
+
+	if err := h.store.Create(p); err == ErrSlugExists {
+		ctx.AbortWithStatusJSON(http.StatusConflict, gin.H{"message": "A post with this slug already exists"})
+		return
+	} else if err != nil {
+		log.Error(fmt.Errorf("Unable to execute request: unable to create post. %+v", err))
+		ctx.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"message": "Unable to create post"})
+		return
+	}
+	h.index.Add(p)
+
+	ctx.JSON(http.StatusCreated, gin.H{"post": p})
+}
+
+// Update godoc
+//
+//	@Summary		Update post
+//	@Description	updates a post owned by the authenticated caller
+//	@Tags			posts
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			slug	path		string				true	"post slug"
+//	@Param			body	body		UpdatePostRequest	true	"post"
+//	@Success		200	{object}	gin.H
+//	@Failure		403	{object}	gin.H
+//	@Failure		404	{object}	gin.H
+//	@Router			/api/v1/posts/{slug} [put]
+func (h *Handler) Update(ctx *gin.Context) {
+	log.Debug("received request to update post")
+
+	var req UpdatePostRequest
+	if err := ctx.ShouldBind(&req); err != nil {
+		log.Error(fmt.Errorf("Unable to execute request: unable to parse request body. %+v", err))
+		ctx.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"message": "Invalid request body"})
+		return
+	}
+
+	slug := ctx.Param("slug")
+	existing, err := h.store.Get(slug)
+	if err == ErrNotFound {
+		ctx.AbortWithStatusJSON(http.StatusNotFound, gin.H{"message": "Post not found"})
+		return
+	} else if err != nil {
+		log.Error(fmt.Errorf("Unable to execute request: unable to fetch post. %+v", err))
+		ctx.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"message": "Unable to update post"})
+		return
+	}
+	if !canModify(ctx, existing) {
+		ctx.AbortWithStatusJSON(http.StatusForbidden, gin.H{"message": "You may only edit your own posts"})
+		return
+	}
+
+	updated := &Post{Title: req.Title, Body: req.Body, Tags: req.Tags}
+	if err := h.store.Update(slug, updated); err == ErrNotFound {
+		ctx.AbortWithStatusJSON(http.StatusNotFound, gin.H{"message": "Post not found"})
+		return
+	} else if err != nil {
+		log.Error(fmt.Errorf("Unable to execute request: unable to update post. %+v", err))
+		ctx.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"message": "Unable to update post"})
+		return
+	}
+
+	p, err := h.store.Get(slug)
+	if err != nil {
+		log.Error(fmt.Errorf("Unable to execute request: unable to reload post after update. %+v", err))
+		ctx.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"message": "Unable to update post"})
+		return
+	}
+	h.index.Remove(existing)
+	h.index.Add(p)
+
+	ctx.JSON(http.StatusOK, gin.H{"post": p})
+}
+
+// Delete godoc
+//
+//	@Summary		Delete post
+//	@Description	deletes a post owned by the authenticated caller
+//	@Tags			posts
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			slug	path		string	true	"post slug"
+//	@Success		200	{object}	gin.H
+//	@Failure		403	{object}	gin.H
+//	@Failure		404	{object}	gin.H
+//	@Router			/api/v1/posts/{slug} [delete]
+func (h *Handler) Delete(ctx *gin.Context) {
+	slug := ctx.Param("slug")
+
+	p, err := h.store.Get(slug)
+	if err == ErrNotFound {
+		ctx.AbortWithStatusJSON(http.StatusNotFound, gin.H{"message": "Post not found"})
+		return
+	} else if err != nil {
+		log.Error(fmt.Errorf("Unable to execute request: unable to fetch post. %+v", err))
+		ctx.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"message": "Unable to delete post"})
+		return
+	}
+	if !canModify(ctx, p) {
+		ctx.AbortWithStatusJSON(http.StatusForbidden, gin.H{"message": "You may only delete your own posts"})
+		return
+	}
+
+	if err := h.store.Delete(slug); err == ErrNotFound {
+		ctx.AbortWithStatusJSON(http.StatusNotFound, gin.H{"message": "Post not found"})
+		return
+	} else if err != nil {
+		log.Error(fmt.Errorf("Unable to execute request: unable to delete post. %+v", err))
+		ctx.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"message": "Unable to delete post"})
+		return
+	}
+
+	// only drop the post from the search index once the store delete has
+	// actually succeeded, so a failed delete can't leave it unsearchable
+	h.index.Remove(p)
+
+	ctx.JSON(http.StatusOK, gin.H{"message": "Post deleted"})
+}
+
+// Search godoc
+//
+//	@Summary		Search posts
+//	@Description	full-text search over post titles and bodies
+//	@Tags			posts
+//	@Produce		json
+//	@Param			q	query		string	true	"search query"
+//	@Success		200	{object}	gin.H
+//	@Failure		400	{object}	gin.H
+//	@Router			/api/v1/search [get]
+func (h *Handler) Search(ctx *gin.Context) {
+	q := ctx.Query("q")
+	if q == "" {
+		ctx.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"message": "Missing search query"})
+		return
+	}
+
+	slugs := h.index.Search(q)
+	results := make([]*Post, 0, len(slugs))
+	for _, slug := range slugs {
+		if p, err := h.store.Get(slug); err == nil {
+			results = append(results, p)
+		}
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"results": results})
+}
+
+// canModify reports whether the authenticated caller in ctx may edit or
+// delete post p: admins may touch any post, authors only their own. The
+// check is per-post rather than a role-gating middleware, since a flat
+// role gate can't express "owner or admin" for the same route.
+func canModify(ctx *gin.Context, p *Post) bool {
+	if role, ok := ctx.Get("role"); ok && role == "admin" {
+		return true
+	}
+	username, ok := ctx.Get("user")
+	return ok && username == p.Author
+}
+
+func slugify(title string) string {
+	lower := strings.ToLower(strings.TrimSpace(title))
+	var b strings.Builder
+	lastDash := false
+	for _, r := range lower {
+		switch {
+		case r >= 'a' && r <= 'z' || r >= '0' && r <= '9':
+			b.WriteRune(r)
+			lastDash = false
+		case !lastDash:
+			b.WriteRune('-')
+			lastDash = true
+		}
+	}
+	return strings.Trim(b.String(), "-")
+}
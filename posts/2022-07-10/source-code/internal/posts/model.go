@@ -0,0 +1,31 @@
+package posts
+
+import "time"
+
+// Post represents a single blog entry. Body is stored as raw Markdown;
+// HTML is rendered on read so the stored record always reflects the
+// author's source of truth.
+type Post struct {
+	ID        string    `json:"id"`
+	Slug      string    `json:"slug"`
+	Title     string    `json:"title" binding:"required"`
+	Body      string    `json:"body" binding:"required"`
+	Tags      []string  `json:"tags"`
+	Author    string    `json:"author"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// CreatePostRequest is the expected payload for POST /api/v1/posts.
+type CreatePostRequest struct {
+	Title string   `json:"title" binding:"required"`
+	Body  string   `json:"body" binding:"required"`
+	Tags  []string `json:"tags"`
+}
+
+// UpdatePostRequest is the expected payload for PUT /api/v1/posts/:slug.
+type UpdatePostRequest struct {
+	Title string   `json:"title" binding:"required"`
+	Body  string   `json:"body" binding:"required"`
+	Tags  []string `json:"tags"`
+}
@@ -0,0 +1,136 @@
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+
+	"github.com/gin-gonic/gin"
+	log "github.com/sirupsen/logrus"
+	swaggerFiles "github.com/swaggo/files"
+	ginSwagger "github.com/swaggo/gin-swagger"
+
+	_ "github.com/tecian-software/tech-blog/docs"
+	"github.com/tecian-software/tech-blog/internal/auth"
+	"github.com/tecian-software/tech-blog/internal/config"
+	"github.com/tecian-software/tech-blog/internal/middleware"
+	"github.com/tecian-software/tech-blog/internal/posts"
+	"github.com/tecian-software/tech-blog/internal/server"
+)
+
+// healthCheck godoc
+//
+//	@Summary		Health check
+//	@Description	reports whether the service is up
+//	@Tags			meta
+//	@Produce		json
+//	@Success		200	{object}	gin.H
+//	@Router			/health_check [get]
+func healthCheck(ctx *gin.Context) {
+	log.Debug("received request for health check handler")
+	ctx.JSON(http.StatusOK, gin.H{"message": "Service is running"})
+}
+
+// exampleAdder godoc
+//
+//	@Summary		Example adder
+//	@Description	adds two integers, used to smoke-test request binding
+//	@Tags			meta
+//	@Accept			json
+//	@Produce		json
+//	@Param			body	body		object{x=int,y=int}	true	"operands"
+//	@Success		200		{object}	gin.H
+//	@Failure		400		{object}	gin.H
+//	@Router			/api/v1/example [post]
+func exampleAdder(ctx *gin.Context) {
+	log.Debug("received request to execute sample endpoint")
+	var r struct {
+		X int `json:"x" binding:"required"`
+		Y int `json:"y" binding:"required"`
+	}
+	// parse request body and raise error if
+	// variables cannot be processed/parsed
+	if err := ctx.ShouldBind(&r); err != nil {
+		log.Error(fmt.Errorf("Unable to execute request: unable to parse request body. %+v", err))
+		ctx.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"message": "Invalid request body"})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"result": r.X + r.Y})
+}
+
+// @title			tech-blog API
+// @version		1.0
+// @description	Blog content, authentication, and operational endpoints for tech-blog.
+// @BasePath		/
+// @securityDefinitions.apikey	BearerAuth
+// @in							header
+// @name						Authorization
+func New() *gin.Engine {
+	middleware.ConfigureLogging()
+
+	// generate new instance of router engine, replacing gin's default
+	// logger/recovery with our own structured, request-ID-aware logging
+	r := gin.New()
+	r.Use(middleware.RequestID())
+	r.Use(middleware.Logger())
+	r.Use(middleware.Metrics())
+	r.Use(middleware.CORS(middleware.CORSConfigFromEnv()))
+	r.Use(middleware.RateLimit(middleware.DefaultRateLimit))
+	// Recovery goes last (closest to the handlers) so a panic is recovered
+	// before it unwinds past Logger/Metrics, letting their post-request
+	// logging and metrics recording still run.
+	r.Use(middleware.Recovery())
+
+	// generate health check endpoint for application
+	r.GET("/health_check", healthCheck)
+
+	r.GET("/metrics", middleware.MetricsHandler())
+	r.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler))
+
+	v1 := r.Group("/api/v1")
+
+	// add POST endpoint to mock functionality
+	v1.POST("/example", exampleAdder)
+
+	// wire up authentication: registration and login are public, everything
+	// else behind JWTAuth attaches the caller to the context
+	authStore := auth.NewMemoryStore()
+	auth.NewHandler(authStore).Register(v1.Group("/auth"), middleware.RateLimit(middleware.LoginRateLimit))
+
+	// wire up the blog subsystem: posts are persisted in-memory by default,
+	// so local runs don't require a database. Reads are public; writes
+	// require a valid token and are scoped to the caller's own posts.
+	postsStore := posts.NewMemoryStore()
+	postsHandler, err := posts.NewHandler(postsStore)
+	if err != nil {
+		log.Fatal(fmt.Errorf("Unable to start application: unable to initialize posts handler. %+v", err))
+	}
+
+	postsGroup := v1.Group("/posts")
+	postsGroup.GET("", postsHandler.List)
+	postsGroup.GET("/:slug", postsHandler.Get)
+
+	postsWrite := v1.Group("/posts", middleware.JWTAuth())
+	postsWrite.POST("", postsHandler.Create)
+	postsWrite.PUT("/:slug", postsHandler.Update)
+	postsWrite.DELETE("/:slug", postsHandler.Delete)
+
+	v1.GET("/search", postsHandler.Search)
+
+	return r
+}
+
+func main() {
+	cfg, err := config.Load(os.Getenv("CONFIG_FILE"))
+	if err != nil {
+		log.Fatal(fmt.Errorf("Unable to start application: unable to load config. %+v", err))
+	}
+
+	// generate new router engine and serve it behind a configurable
+	// *http.Server that shuts down gracefully on SIGINT/SIGTERM
+	router := New()
+	if err := server.Run(cfg, router); err != nil {
+		log.Fatal(fmt.Errorf("Unable to start application: %+v", err))
+	}
+}